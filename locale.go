@@ -0,0 +1,931 @@
+package rtime
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Locale holds every name table Format consults when it expands a Russian-style
+// placeholder. The canonical placeholder tokens recognized in a layout (Январь,
+// Понедельник, г., etc.) always stay Russian; a Locale only controls what text gets
+// substituted for them, which is how the same GOST2016Word layout can render
+// "1 марта 2023 г." under LocaleRU and "1 березня 2023 р." under LocaleUK.
+//
+// All slices must have 12 entries for months and 7 for weekdays, in the same order as
+// the built-in locales (January..December, Monday..Sunday).
+type Locale struct {
+	LongMonth              []string // Январь, Февраль, ...
+	LongMonthLower         []string // январь, февраль, ...
+	Month                  []string // Янв, Фев, ...
+	MonthLower             []string // янв, фев, ...
+	LongMonthGenitive      []string // Января, Февраля, ...
+	LongMonthGenitiveLower []string // января, февраля, ...
+
+	// LongMonthAccusative/LongMonthAccusativeLower are a deliberate partial feature: they have
+	// no matching codeLongMonthAccusative and no Format placeholder, because for Russian and
+	// the other locales here the accusative of a month name is spelled exactly like the
+	// nominative (inanimate masculine noun) — there is no text nextChunk could ever use to
+	// tell the two apart in a layout. They exist only for callers that want the accusative
+	// table directly, and default to the nominative tables below.
+	LongMonthAccusative      []string // Январь, Февраль, ... (same as LongMonth)
+	LongMonthAccusativeLower []string // январь, февраль, ... (same as LongMonthLower)
+
+	LongMonthDative             []string // Январю, Февралю, ...
+	LongMonthDativeLower        []string // январю, февралю, ...
+	LongMonthInstrumental       []string // Январём, Февралём, ...
+	LongMonthInstrumentalLower  []string // январём, февралём, ...
+	LongMonthPrepositional      []string // Январе, Феврале, ...
+	LongMonthPrepositionalLower []string // январе, феврале, ...
+
+	LongWeekDay      []string // Понедельник, Вторник, ...
+	LongWeekDayLower []string // понедельник, вторник, ...
+	WeekDay          []string // ПН, ВТ, ...
+	WeekDayLower     []string // пн, вт, ...
+
+	// YearSuffix is substituted for the "г." placeholder, e.g. "р." in LocaleUK.
+	YearSuffix string
+}
+
+// lowerAll returns a copy of names with every entry lowercased.
+func lowerAll(names []string) []string {
+	lower := make([]string, len(names))
+	for i, name := range names {
+		lower[i] = strings.ToLower(name)
+	}
+	return lower
+}
+
+var ruLongMonth = []string{
+	"Январь",
+	"Февраль",
+	"Март",
+	"Апрель",
+	"Май",
+	"Июнь",
+	"Июль",
+	"Август",
+	"Сентябрь",
+	"Октябрь",
+	"Ноябрь",
+	"Декабрь",
+}
+
+var ruMonth = []string{
+	"Янв",
+	"Фев",
+	"Мар",
+	"Апр",
+	"Май",
+	"Июнь",
+	"Июль",
+	"Авг",
+	"Сен",
+	"Окт",
+	"Ноя",
+	"Дек",
+}
+
+var ruLongMonthGenitive = []string{
+	"Января",
+	"Февраля",
+	"Марта",
+	"Апреля",
+	"Мая",
+	"Июня",
+	"Июля",
+	"Августа",
+	"Сентября",
+	"Октября",
+	"Ноября",
+	"Декабря",
+}
+
+var ruLongMonthDative = []string{
+	"Январю",
+	"Февралю",
+	"Марту",
+	"Апрелю",
+	"Маю",
+	"Июню",
+	"Июлю",
+	"Августу",
+	"Сентябрю",
+	"Октябрю",
+	"Ноябрю",
+	"Декабрю",
+}
+
+var ruLongMonthInstrumental = []string{
+	"Январём",
+	"Февралём",
+	"Мартом",
+	"Апрелем",
+	"Маем",
+	"Июнем",
+	"Июлем",
+	"Августом",
+	"Сентябрём",
+	"Октябрём",
+	"Ноябрём",
+	"Декабрём",
+}
+
+var ruLongMonthPrepositional = []string{
+	"Январе",
+	"Феврале",
+	"Марте",
+	"Апреле",
+	"Мае",
+	"Июне",
+	"Июле",
+	"Августе",
+	"Сентябре",
+	"Октябре",
+	"Ноябре",
+	"Декабре",
+}
+
+var ruLongWeekDay = []string{
+	"Понедельник",
+	"Вторник",
+	"Среда",
+	"Четверг",
+	"Пятница",
+	"Суббота",
+	"Воскресенье",
+}
+
+var ruWeekDay = []string{
+	"ПН",
+	"ВТ",
+	"СР",
+	"ЧТ",
+	"ПТ",
+	"СБ",
+	"ВС",
+}
+
+// LocaleRU is the built-in Russian locale. It is also the package's default locale.
+var LocaleRU = &Locale{
+	LongMonth:                   ruLongMonth,
+	LongMonthLower:              lowerAll(ruLongMonth),
+	Month:                       ruMonth,
+	MonthLower:                  lowerAll(ruMonth),
+	LongMonthGenitive:           ruLongMonthGenitive,
+	LongMonthGenitiveLower:      lowerAll(ruLongMonthGenitive),
+	LongMonthAccusative:         ruLongMonth,
+	LongMonthAccusativeLower:    lowerAll(ruLongMonth),
+	LongMonthDative:             ruLongMonthDative,
+	LongMonthDativeLower:        lowerAll(ruLongMonthDative),
+	LongMonthInstrumental:       ruLongMonthInstrumental,
+	LongMonthInstrumentalLower:  lowerAll(ruLongMonthInstrumental),
+	LongMonthPrepositional:      ruLongMonthPrepositional,
+	LongMonthPrepositionalLower: lowerAll(ruLongMonthPrepositional),
+	LongWeekDay:                 ruLongWeekDay,
+	LongWeekDayLower:            lowerAll(ruLongWeekDay),
+	WeekDay:                     ruWeekDay,
+	WeekDayLower:                lowerAll(ruWeekDay),
+	YearSuffix:                  "г.",
+}
+
+var ukLongMonth = []string{
+	"Січень",
+	"Лютий",
+	"Березень",
+	"Квітень",
+	"Травень",
+	"Червень",
+	"Липень",
+	"Серпень",
+	"Вересень",
+	"Жовтень",
+	"Листопад",
+	"Грудень",
+}
+
+var ukMonth = []string{
+	"Січ",
+	"Лют",
+	"Бер",
+	"Кві",
+	"Тра",
+	"Чер",
+	"Лип",
+	"Сер",
+	"Вер",
+	"Жов",
+	"Лис",
+	"Гру",
+}
+
+var ukLongMonthGenitive = []string{
+	"Січня",
+	"Лютого",
+	"Березня",
+	"Квітня",
+	"Травня",
+	"Червня",
+	"Липня",
+	"Серпня",
+	"Вересня",
+	"Жовтня",
+	"Листопада",
+	"Грудня",
+}
+
+var ukLongMonthDative = []string{
+	"Січню",
+	"Лютому",
+	"Березню",
+	"Квітню",
+	"Травню",
+	"Червню",
+	"Липню",
+	"Серпню",
+	"Вересню",
+	"Жовтню",
+	"Листопаду",
+	"Грудню",
+}
+
+var ukLongMonthInstrumental = []string{
+	"Січнем",
+	"Лютим",
+	"Березнем",
+	"Квітнем",
+	"Травнем",
+	"Червнем",
+	"Липнем",
+	"Серпнем",
+	"Вереснем",
+	"Жовтнем",
+	"Листопадом",
+	"Груднем",
+}
+
+var ukLongMonthPrepositional = []string{
+	"Січні",
+	"Лютому",
+	"Березні",
+	"Квітні",
+	"Травні",
+	"Червні",
+	"Липні",
+	"Серпні",
+	"Вересні",
+	"Жовтні",
+	"Листопаді",
+	"Грудні",
+}
+
+var ukLongWeekDay = []string{
+	"Понеділок",
+	"Вівторок",
+	"Середа",
+	"Четвер",
+	"П'ятниця",
+	"Субота",
+	"Неділя",
+}
+
+var ukWeekDay = []string{
+	"ПН",
+	"ВТ",
+	"СР",
+	"ЧТ",
+	"ПТ",
+	"СБ",
+	"НД",
+}
+
+// LocaleUK is the built-in Ukrainian locale.
+var LocaleUK = &Locale{
+	LongMonth:                   ukLongMonth,
+	LongMonthLower:              lowerAll(ukLongMonth),
+	Month:                       ukMonth,
+	MonthLower:                  lowerAll(ukMonth),
+	LongMonthGenitive:           ukLongMonthGenitive,
+	LongMonthGenitiveLower:      lowerAll(ukLongMonthGenitive),
+	LongMonthAccusative:         ukLongMonth,
+	LongMonthAccusativeLower:    lowerAll(ukLongMonth),
+	LongMonthDative:             ukLongMonthDative,
+	LongMonthDativeLower:        lowerAll(ukLongMonthDative),
+	LongMonthInstrumental:       ukLongMonthInstrumental,
+	LongMonthInstrumentalLower:  lowerAll(ukLongMonthInstrumental),
+	LongMonthPrepositional:      ukLongMonthPrepositional,
+	LongMonthPrepositionalLower: lowerAll(ukLongMonthPrepositional),
+	LongWeekDay:                 ukLongWeekDay,
+	LongWeekDayLower:            lowerAll(ukLongWeekDay),
+	WeekDay:                     ukWeekDay,
+	WeekDayLower:                lowerAll(ukWeekDay),
+	YearSuffix:                  "р.",
+}
+
+var beLongMonth = []string{
+	"Студзень",
+	"Люты",
+	"Сакавік",
+	"Красавік",
+	"Май",
+	"Чэрвень",
+	"Ліпень",
+	"Жнівень",
+	"Верасень",
+	"Кастрычнік",
+	"Лістапад",
+	"Снежань",
+}
+
+var beMonth = []string{
+	"Студ",
+	"Лют",
+	"Сак",
+	"Крас",
+	"Май",
+	"Чэрв",
+	"Ліп",
+	"Жнів",
+	"Вер",
+	"Каст",
+	"Ліст",
+	"Снеж",
+}
+
+var beLongMonthGenitive = []string{
+	"Студзеня",
+	"Лютага",
+	"Сакавіка",
+	"Красавіка",
+	"Мая",
+	"Чэрвеня",
+	"Ліпеня",
+	"Жніўня",
+	"Верасня",
+	"Кастрычніка",
+	"Лістапада",
+	"Снежня",
+}
+
+var beLongMonthDative = []string{
+	"Студзеню",
+	"Лютаму",
+	"Сакавіку",
+	"Красавіку",
+	"Маю",
+	"Чэрвеню",
+	"Ліпеню",
+	"Жніўню",
+	"Верасню",
+	"Кастрычніку",
+	"Лістападу",
+	"Снежню",
+}
+
+var beLongMonthInstrumental = []string{
+	"Студзенем",
+	"Лютым",
+	"Сакавіком",
+	"Красавіком",
+	"Маем",
+	"Чэрвенем",
+	"Ліпенем",
+	"Жніўнем",
+	"Вераснем",
+	"Кастрычнікам",
+	"Лістападам",
+	"Снежнем",
+}
+
+var beLongMonthPrepositional = []string{
+	"Студзені",
+	"Лютым",
+	"Сакавіку",
+	"Красавіку",
+	"Маі",
+	"Чэрвені",
+	"Ліпені",
+	"Жніўні",
+	"Верасні",
+	"Кастрычніку",
+	"Лістападзе",
+	"Снежні",
+}
+
+var beLongWeekDay = []string{
+	"Панядзелак",
+	"Аўторак",
+	"Серада",
+	"Чацвер",
+	"Пятніца",
+	"Субота",
+	"Нядзеля",
+}
+
+var beWeekDay = []string{
+	"ПН",
+	"АЎ",
+	"СР",
+	"ЧЦ",
+	"ПТ",
+	"СБ",
+	"НД",
+}
+
+// LocaleBE is the built-in Belarusian locale.
+var LocaleBE = &Locale{
+	LongMonth:                   beLongMonth,
+	LongMonthLower:              lowerAll(beLongMonth),
+	Month:                       beMonth,
+	MonthLower:                  lowerAll(beMonth),
+	LongMonthGenitive:           beLongMonthGenitive,
+	LongMonthGenitiveLower:      lowerAll(beLongMonthGenitive),
+	LongMonthAccusative:         beLongMonth,
+	LongMonthAccusativeLower:    lowerAll(beLongMonth),
+	LongMonthDative:             beLongMonthDative,
+	LongMonthDativeLower:        lowerAll(beLongMonthDative),
+	LongMonthInstrumental:       beLongMonthInstrumental,
+	LongMonthInstrumentalLower:  lowerAll(beLongMonthInstrumental),
+	LongMonthPrepositional:      beLongMonthPrepositional,
+	LongMonthPrepositionalLower: lowerAll(beLongMonthPrepositional),
+	LongWeekDay:                 beLongWeekDay,
+	LongWeekDayLower:            lowerAll(beLongWeekDay),
+	WeekDay:                     beWeekDay,
+	WeekDayLower:                lowerAll(beWeekDay),
+	YearSuffix:                  "г.",
+}
+
+var kkLongMonth = []string{
+	"Қаңтар",
+	"Ақпан",
+	"Наурыз",
+	"Сәуір",
+	"Мамыр",
+	"Маусым",
+	"Шілде",
+	"Тамыз",
+	"Қыркүйек",
+	"Қазан",
+	"Қараша",
+	"Желтоқсан",
+}
+
+var kkMonth = []string{
+	"Қаң",
+	"Ақп",
+	"Нау",
+	"Сәу",
+	"Мам",
+	"Мау",
+	"Шіл",
+	"Там",
+	"Қыр",
+	"Қаз",
+	"Қар",
+	"Жел",
+}
+
+var kkLongWeekDay = []string{
+	"Дүйсенбі",
+	"Сейсенбі",
+	"Сәрсенбі",
+	"Бейсенбі",
+	"Жұма",
+	"Сенбі",
+	"Жексенбі",
+}
+
+var kkWeekDay = []string{
+	"ДС",
+	"СС",
+	"СР",
+	"БС",
+	"ЖМ",
+	"СБ",
+	"ЖС",
+}
+
+// LocaleKK is the built-in Kazakh locale. Kazakh month names do not decline by
+// grammatical case the way Slavic ones do, so the genitive and other case tables all equal
+// the nominative.
+var LocaleKK = &Locale{
+	LongMonth:                   kkLongMonth,
+	LongMonthLower:              lowerAll(kkLongMonth),
+	Month:                       kkMonth,
+	MonthLower:                  lowerAll(kkMonth),
+	LongMonthGenitive:           kkLongMonth,
+	LongMonthGenitiveLower:      lowerAll(kkLongMonth),
+	LongMonthAccusative:         kkLongMonth,
+	LongMonthAccusativeLower:    lowerAll(kkLongMonth),
+	LongMonthDative:             kkLongMonth,
+	LongMonthDativeLower:        lowerAll(kkLongMonth),
+	LongMonthInstrumental:       kkLongMonth,
+	LongMonthInstrumentalLower:  lowerAll(kkLongMonth),
+	LongMonthPrepositional:      kkLongMonth,
+	LongMonthPrepositionalLower: lowerAll(kkLongMonth),
+	LongWeekDay:                 kkLongWeekDay,
+	LongWeekDayLower:            lowerAll(kkLongWeekDay),
+	WeekDay:                     kkWeekDay,
+	WeekDayLower:                lowerAll(kkWeekDay),
+	YearSuffix:                  "ж.",
+}
+
+var bgLongMonth = []string{
+	"Януари",
+	"Февруари",
+	"Март",
+	"Април",
+	"Май",
+	"Юни",
+	"Юли",
+	"Август",
+	"Септември",
+	"Октомври",
+	"Ноември",
+	"Декември",
+}
+
+var bgMonth = []string{
+	"Яну",
+	"Фев",
+	"Мар",
+	"Апр",
+	"Май",
+	"Юни",
+	"Юли",
+	"Авг",
+	"Сеп",
+	"Окт",
+	"Ное",
+	"Дек",
+}
+
+var bgLongWeekDay = []string{
+	"Понеделник",
+	"Вторник",
+	"Сряда",
+	"Четвъртък",
+	"Петък",
+	"Събота",
+	"Неделя",
+}
+
+var bgWeekDay = []string{
+	"ПН",
+	"ВТ",
+	"СР",
+	"ЧТ",
+	"ПТ",
+	"СБ",
+	"НД",
+}
+
+// LocaleBG is the built-in Bulgarian locale. Bulgarian, unlike the other Slavic locales
+// here, lost noun case declension, so every case table equals the nominative one.
+var LocaleBG = &Locale{
+	LongMonth:                   bgLongMonth,
+	LongMonthLower:              lowerAll(bgLongMonth),
+	Month:                       bgMonth,
+	MonthLower:                  lowerAll(bgMonth),
+	LongMonthGenitive:           bgLongMonth,
+	LongMonthGenitiveLower:      lowerAll(bgLongMonth),
+	LongMonthAccusative:         bgLongMonth,
+	LongMonthAccusativeLower:    lowerAll(bgLongMonth),
+	LongMonthDative:             bgLongMonth,
+	LongMonthDativeLower:        lowerAll(bgLongMonth),
+	LongMonthInstrumental:       bgLongMonth,
+	LongMonthInstrumentalLower:  lowerAll(bgLongMonth),
+	LongMonthPrepositional:      bgLongMonth,
+	LongMonthPrepositionalLower: lowerAll(bgLongMonth),
+	LongWeekDay:                 bgLongWeekDay,
+	LongWeekDayLower:            lowerAll(bgLongWeekDay),
+	WeekDay:                     bgWeekDay,
+	WeekDayLower:                lowerAll(bgWeekDay),
+	YearSuffix:                  "г.",
+}
+
+var srLongMonth = []string{
+	"Јануар",
+	"Фебруар",
+	"Март",
+	"Април",
+	"Мај",
+	"Јун",
+	"Јул",
+	"Август",
+	"Септембар",
+	"Октобар",
+	"Новембар",
+	"Децембар",
+}
+
+var srMonth = []string{
+	"Јан",
+	"Феб",
+	"Мар",
+	"Апр",
+	"Мај",
+	"Јун",
+	"Јул",
+	"Авг",
+	"Сеп",
+	"Окт",
+	"Нов",
+	"Дец",
+}
+
+var srLongMonthGenitive = []string{
+	"Јануара",
+	"Фебруара",
+	"Марта",
+	"Априла",
+	"Маја",
+	"Јуна",
+	"Јула",
+	"Августа",
+	"Септембра",
+	"Октобра",
+	"Новембра",
+	"Децембра",
+}
+
+var srLongMonthDative = []string{
+	"Јануару",
+	"Фебруару",
+	"Марту",
+	"Априлу",
+	"Мају",
+	"Јуну",
+	"Јулу",
+	"Августу",
+	"Септембру",
+	"Октобру",
+	"Новембру",
+	"Децембру",
+}
+
+var srLongMonthInstrumental = []string{
+	"Јануаром",
+	"Фебруаром",
+	"Мартом",
+	"Априлом",
+	"Мајем",
+	"Јуном",
+	"Јулом",
+	"Августом",
+	"Септембром",
+	"Октобром",
+	"Новембром",
+	"Децембром",
+}
+
+// srLongMonthPrepositional equals srLongMonthDative: Serbian masculine nouns like month
+// names use the same ending for the dative and locative case ("у јануару" / "о јануару").
+var srLongMonthPrepositional = srLongMonthDative
+
+var srLongWeekDay = []string{
+	"Понедељак",
+	"Уторак",
+	"Среда",
+	"Четвртак",
+	"Петак",
+	"Субота",
+	"Недеља",
+}
+
+var srWeekDay = []string{
+	"ПО",
+	"УТ",
+	"СР",
+	"ЧЕ",
+	"ПЕ",
+	"СУ",
+	"НЕ",
+}
+
+// LocaleSR is the built-in Serbian locale (Cyrillic orthography).
+var LocaleSR = &Locale{
+	LongMonth:                   srLongMonth,
+	LongMonthLower:              lowerAll(srLongMonth),
+	Month:                       srMonth,
+	MonthLower:                  lowerAll(srMonth),
+	LongMonthGenitive:           srLongMonthGenitive,
+	LongMonthGenitiveLower:      lowerAll(srLongMonthGenitive),
+	LongMonthAccusative:         srLongMonth,
+	LongMonthAccusativeLower:    lowerAll(srLongMonth),
+	LongMonthDative:             srLongMonthDative,
+	LongMonthDativeLower:        lowerAll(srLongMonthDative),
+	LongMonthInstrumental:       srLongMonthInstrumental,
+	LongMonthInstrumentalLower:  lowerAll(srLongMonthInstrumental),
+	LongMonthPrepositional:      srLongMonthPrepositional,
+	LongMonthPrepositionalLower: lowerAll(srLongMonthPrepositional),
+	LongWeekDay:                 srLongWeekDay,
+	LongWeekDayLower:            lowerAll(srLongWeekDay),
+	WeekDay:                     srWeekDay,
+	WeekDayLower:                lowerAll(srWeekDay),
+	YearSuffix:                  "г.",
+}
+
+// defaultLocale is the locale Format falls back to when an RTime has no locale of its own.
+// It is guarded by an atomic pointer rather than a plain package variable because Format
+// reads it from any number of goroutines while SetDefaultLocale/SetXxxNames can replace it
+// concurrently from another one. It starts out as a copy of LocaleRU. SetXxxNames (see
+// below) never mutate the Locale they read: they store a modified copy in its place, so the
+// exported LocaleRU value is left unchanged.
+var defaultLocale atomic.Pointer[Locale]
+
+func init() {
+	ru := *LocaleRU
+	defaultLocale.Store(&ru)
+}
+
+// DefaultLocale returns the locale Format currently falls back to for an RTime with no
+// locale of its own. Safe to call concurrently with SetDefaultLocale.
+func DefaultLocale() *Locale {
+	return defaultLocale.Load()
+}
+
+// SetDefaultLocale replaces the locale Format falls back to for an RTime with no locale of
+// its own. Safe to call concurrently with Format and DefaultLocale.
+func SetDefaultLocale(l *Locale) {
+	defaultLocale.Store(l)
+}
+
+var (
+	localeRegistryMu sync.RWMutex
+	localeRegistry   = map[string]*Locale{
+		"ru": LocaleRU,
+		"uk": LocaleUK,
+		"be": LocaleBE,
+		"kk": LocaleKK,
+		"bg": LocaleBG,
+		"sr": LocaleSR,
+	}
+)
+
+// RegisterLocale makes l available under name for later retrieval via LookupLocale, so a
+// caller can select a locale by a name coming from config or user input (e.g. a request
+// parameter) instead of referencing one of the exported Locale variables directly. The six
+// built-in locales are pre-registered under "ru", "uk", "be", "kk", "bg" and "sr".
+func RegisterLocale(name string, l *Locale) {
+	localeRegistryMu.Lock()
+	defer localeRegistryMu.Unlock()
+	localeRegistry[name] = l
+}
+
+// LookupLocale returns the locale registered under name, and whether one was found.
+func LookupLocale(name string) (*Locale, bool) {
+	localeRegistryMu.RLock()
+	defer localeRegistryMu.RUnlock()
+	l, ok := localeRegistry[name]
+	return l, ok
+}
+
+var ErrInvalidNamesList = errors.New("invalid new names list")
+
+// SetMonthNames set short month names (Янв, Фев, etc.) on the default locale.
+func SetMonthNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.Month) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.Month = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetMonthLowerNames set short month lower names (янв, фев, etc.) on the default locale.
+func SetMonthLowerNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.MonthLower) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.MonthLower = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetWeekDayNames set short week day names (ПН, ВТ, etc.) on the default locale.
+func SetWeekDayNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.WeekDay) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.WeekDay = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetWeekDayLowerNames set short week day lower names (пн, вт, etc.) on the default locale.
+func SetWeekDayLowerNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.WeekDayLower) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.WeekDayLower = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetLongMonthDativeNames sets long dative month names (Январю, Февралю, etc.) on the default locale.
+func SetLongMonthDativeNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.LongMonthDative) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.LongMonthDative = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetLongMonthDativeLowerNames sets long dative lower month names (январю, февралю, etc.) on the default locale.
+func SetLongMonthDativeLowerNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.LongMonthDativeLower) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.LongMonthDativeLower = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetLongMonthAccusativeNames sets long accusative month names on the default locale. There
+// is no Format placeholder for these (see Locale.LongMonthAccusative); the setter exists so
+// callers with a locale where accusative genuinely differs from nominative can still override it.
+func SetLongMonthAccusativeNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.LongMonthAccusative) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.LongMonthAccusative = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetLongMonthAccusativeLowerNames sets long accusative lower month names on the default locale.
+func SetLongMonthAccusativeLowerNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.LongMonthAccusativeLower) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.LongMonthAccusativeLower = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetLongMonthInstrumentalNames sets long instrumental month names (Январём, Февралём, etc.) on the default locale.
+func SetLongMonthInstrumentalNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.LongMonthInstrumental) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.LongMonthInstrumental = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetLongMonthInstrumentalLowerNames sets long instrumental lower month names (январём, февралём, etc.) on the default locale.
+func SetLongMonthInstrumentalLowerNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.LongMonthInstrumentalLower) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.LongMonthInstrumentalLower = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetLongMonthPrepositionalNames sets long prepositional month names (Январе, Феврале, etc.) on the default locale.
+func SetLongMonthPrepositionalNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.LongMonthPrepositional) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.LongMonthPrepositional = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
+
+// SetLongMonthPrepositionalLowerNames sets long prepositional lower month names (январе, феврале, etc.) on the default locale.
+func SetLongMonthPrepositionalLowerNames(newNames []string) error {
+	cur := defaultLocale.Load()
+	if len(newNames) != len(cur.LongMonthPrepositionalLower) {
+		return ErrInvalidNamesList
+	}
+	next := *cur
+	next.LongMonthPrepositionalLower = newNames
+	defaultLocale.Store(&next)
+	return nil
+}
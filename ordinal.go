@@ -0,0 +1,61 @@
+package rtime
+
+import "fmt"
+
+// OrdinalForm selects which Russian ordinal suffix OrdinalDay, and the matching "2-.."
+// Format placeholders, append to a day-of-month number.
+type OrdinalForm int
+
+const (
+	OrdinalNeuter        OrdinalForm = iota // 1-е, 2-е, 3-е, 23-е
+	OrdinalNeuterLong                       // 1-ое, 2-ое, 3-ое, 23-ое
+	OrdinalMasculine                        // 1-й, 2-й, 3-й, 23-й
+	OrdinalMasculineLong                    // 1-ой, 2-ой, 3-ой, 23-ой
+	OrdinalGenitive                         // 1-го, 2-го, 3-го, 23-го
+)
+
+var ordinalSuffixes = map[OrdinalForm]string{
+	OrdinalNeuter:        "е",
+	OrdinalNeuterLong:    "ое",
+	OrdinalMasculine:     "й",
+	OrdinalMasculineLong: "ой",
+	OrdinalGenitive:      "го",
+}
+
+// ordinalSentinels are placeholders FormatLocale splices into the layout in place of an
+// ordinal placeholder, instead of the real day digits. Using a private-use-area rune (rather
+// than the digits themselves) means t.Time.Format can never mistake them for one of its own
+// reference-date codes; FormatLocale swaps them back for OrdinalDay(t.Day(), form) after
+// t.Time.Format has already run.
+var ordinalSentinels = map[OrdinalForm]string{
+	OrdinalNeuter:        "",
+	OrdinalNeuterLong:    "",
+	OrdinalMasculine:     "",
+	OrdinalMasculineLong: "",
+	OrdinalGenitive:      "",
+}
+
+// OrdinalDay renders n as a Russian ordinal day number in the given form, e.g.
+// OrdinalDay(1, OrdinalGenitive) returns "1-го" and OrdinalDay(23, OrdinalNeuter) returns
+// "23-е". It is the same expansion Format performs for the "2-е"/"2-й"/"2-го"/"2-ое"/"2-ой"
+// layout placeholders, exposed so callers can compose ordinals outside of Format.
+func OrdinalDay(n int, form OrdinalForm) string {
+	return fmt.Sprintf("%d-%s", n, ordinalSuffixes[form])
+}
+
+// ordinalFormForCode maps a nextChunk ordinal code back to the OrdinalForm Format should
+// render it with.
+func ordinalFormForCode(code int) OrdinalForm {
+	switch code {
+	case codeOrdinalNeuterLong:
+		return OrdinalNeuterLong
+	case codeOrdinalMasculine:
+		return OrdinalMasculine
+	case codeOrdinalMasculineLong:
+		return OrdinalMasculineLong
+	case codeOrdinalGenitive:
+		return OrdinalGenitive
+	default:
+		return OrdinalNeuter
+	}
+}
@@ -8,13 +8,13 @@
 // List of the new placeholders: Январь, январь, Янв, янв, Января, января, Понедельник, понедельник, ПН, пн.
 // Every placeholder existing in the original time package works as usual:
 //
-//	t := rtime.RTime{time.Now()}
+//	t := rtime.RTime{Time: time.Now()}
 //	t.Format("ПН/Mon, 2 Янв/Jan 2006") // "СР/Wed, 1 Мар/Mar 2023
 package rtime
 
 import (
-	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -45,187 +45,38 @@ const (
 	GOST2003NumericReverse = "2006.01.02"
 )
 
+// There is deliberately no codeLongMonthAccusative/codeLongMonthAccusativeLower here: the
+// accusative of a Russian (and Ukrainian/Belarusian/Serbian) month name is spelled exactly
+// like the nominative (inanimate masculine noun), so no placeholder text could ever tell
+// nextChunk apart from codeLongMonth/codeLongMonthLower. Locale still carries
+// LongMonthAccusative/LongMonthAccusativeLower (see locale.go) for callers that want the
+// table directly; that is the full extent of accusative support this package can offer.
 const (
-	codeLongMonth              = iota + 1 // Январь
-	codeLongMonthLower                    // январь
-	codeMonth                             // Янв
-	codeMonthLower                        // янв
-	codeLongMonthGenitive                 // Января
-	codeLongMonthGenitiveLower            // января
-	codeLongWeekDay                       // Понедельник
-	codeLongWeekDayLower                  // понедельник
-	codeWeekDay                           // ПН
-	codeWeekDayLower                      // пн
+	codeLongMonth                   = iota + 1 // Январь
+	codeLongMonthLower                         // январь
+	codeMonth                                  // Янв
+	codeMonthLower                             // янв
+	codeLongMonthGenitive                      // Января
+	codeLongMonthGenitiveLower                 // января
+	codeLongMonthDative                        // Январю
+	codeLongMonthDativeLower                   // январю
+	codeLongMonthInstrumental                  // Январём
+	codeLongMonthInstrumentalLower             // январём
+	codeLongMonthPrepositional                 // Январе
+	codeLongMonthPrepositionalLower            // январе
+	codeLongWeekDay                            // Понедельник
+	codeLongWeekDayLower                       // понедельник
+	codeWeekDay                                // ПН
+	codeWeekDayLower                           // пн
+	codeYearSuffix                             // г.
+
+	codeOrdinalNeuter        // 2-е
+	codeOrdinalNeuterLong    // 2-ое
+	codeOrdinalMasculine     // 2-й
+	codeOrdinalMasculineLong // 2-ой
+	codeOrdinalGenitive      // 2-го
 )
 
-var longMonthNames = []string{
-	"Январь",
-	"Февраль",
-	"Март",
-	"Апрель",
-	"Май",
-	"Июнь",
-	"Июль",
-	"Август",
-	"Сентябрь",
-	"Октябрь",
-	"Ноябрь",
-	"Декабрь",
-}
-
-var longMonthLowerNames = []string{
-	"январь",
-	"февраль",
-	"март",
-	"апрель",
-	"май",
-	"июнь",
-	"июль",
-	"август",
-	"сентябрь",
-	"октябрь",
-	"ноябрь",
-	"декабрь",
-}
-
-var monthNames = []string{
-	"Янв",
-	"Фев",
-	"Мар",
-	"Апр",
-	"Май",
-	"Июнь",
-	"Июль",
-	"Авг",
-	"Сен",
-	"Окт",
-	"Ноя",
-	"Дек",
-}
-
-var monthLowerNames = []string{
-	"янв",
-	"фев",
-	"мар",
-	"апр",
-	"май",
-	"июнь",
-	"июль",
-	"авг",
-	"сен",
-	"окт",
-	"ноя",
-	"дек",
-}
-
-var longMonthGenitiveNames = []string{
-	"Января",
-	"Февраля",
-	"Марта",
-	"Апреля",
-	"Мая",
-	"Июня",
-	"Июля",
-	"Августа",
-	"Сентября",
-	"Октября",
-	"Ноября",
-	"Декабря",
-}
-
-var longMonthGenitiveLowerNames = []string{
-	"января",
-	"февраля",
-	"марта",
-	"апреля",
-	"мая",
-	"июня",
-	"июля",
-	"августа",
-	"сентября",
-	"октября",
-	"ноября",
-	"декабря",
-}
-
-var longWeekDayNames = []string{
-	"Понедельник",
-	"Вторник",
-	"Среда",
-	"Четверг",
-	"Пятница",
-	"Суббота",
-	"Воскресенье",
-}
-
-var longWeekDayLowerNames = []string{
-	"понедельник",
-	"вторник",
-	"среда",
-	"четверг",
-	"пятница",
-	"суббота",
-	"воскресенье",
-}
-
-var weekDayNames = []string{
-	"ПН",
-	"ВТ",
-	"СР",
-	"ЧТ",
-	"ПТ",
-	"СБ",
-	"ВС",
-}
-
-var weekDayLowerNames = []string{
-	"пн",
-	"вт",
-	"ср",
-	"чт",
-	"пт",
-	"сб",
-	"вс",
-}
-
-var ErrInvalidNamesList = errors.New("invalid new names list")
-
-// SetMonthNames set short month names (Янв, Фев, etc.)
-func SetMonthNames(newNames []string) error {
-	if len(newNames) != len(monthNames) {
-		return ErrInvalidNamesList
-	}
-	monthNames = newNames
-	return nil
-}
-
-// SetMonthLowerNames set short month lower names (янв, фев, etc.)
-func SetMonthLowerNames(newNames []string) error {
-	if len(newNames) != len(monthLowerNames) {
-		return ErrInvalidNamesList
-	}
-	monthLowerNames = newNames
-	return nil
-}
-
-// SetWeekDayNames set short week day names (ПН, ВТ, etc.)
-func SetWeekDayNames(newNames []string) error {
-	if len(newNames) != len(weekDayNames) {
-		return ErrInvalidNamesList
-	}
-	weekDayNames = newNames
-	return nil
-}
-
-// SetWeekDayLowerNames set short week day lower names (пн, вт, etc.)
-func SetWeekDayLowerNames(newNames []string) error {
-	if len(newNames) != len(weekDayLowerNames) {
-		return ErrInvalidNamesList
-	}
-	weekDayLowerNames = newNames
-	return nil
-}
-
 type month int
 
 const (
@@ -243,21 +94,33 @@ const (
 	December
 )
 
-func (m month) string(code int) string {
+func (m month) string(code int, loc *Locale) string {
 	if January <= m && m <= December {
 		switch code {
 		case codeLongMonth:
-			return longMonthNames[m-1]
+			return loc.LongMonth[m-1]
 		case codeLongMonthLower:
-			return longMonthLowerNames[m-1]
+			return loc.LongMonthLower[m-1]
 		case codeMonth:
-			return monthNames[m-1]
+			return loc.Month[m-1]
 		case codeMonthLower:
-			return monthLowerNames[m-1]
+			return loc.MonthLower[m-1]
 		case codeLongMonthGenitive:
-			return longMonthGenitiveNames[m-1]
+			return loc.LongMonthGenitive[m-1]
 		case codeLongMonthGenitiveLower:
-			return longMonthGenitiveLowerNames[m-1]
+			return loc.LongMonthGenitiveLower[m-1]
+		case codeLongMonthDative:
+			return loc.LongMonthDative[m-1]
+		case codeLongMonthDativeLower:
+			return loc.LongMonthDativeLower[m-1]
+		case codeLongMonthInstrumental:
+			return loc.LongMonthInstrumental[m-1]
+		case codeLongMonthInstrumentalLower:
+			return loc.LongMonthInstrumentalLower[m-1]
+		case codeLongMonthPrepositional:
+			return loc.LongMonthPrepositional[m-1]
+		case codeLongMonthPrepositionalLower:
+			return loc.LongMonthPrepositionalLower[m-1]
 		default:
 			return fmt.Sprintf("Month(%d)", m)
 		}
@@ -278,17 +141,17 @@ const (
 	Sunday
 )
 
-func (d day) string(code int) string {
+func (d day) string(code int, loc *Locale) string {
 	if Monday <= d && d <= Sunday {
 		switch code {
 		case codeWeekDay:
-			return weekDayNames[d-1]
+			return loc.WeekDay[d-1]
 		case codeWeekDayLower:
-			return weekDayLowerNames[d-1]
+			return loc.WeekDayLower[d-1]
 		case codeLongWeekDay:
-			return longWeekDayNames[d-1]
+			return loc.LongWeekDay[d-1]
 		case codeLongWeekDayLower:
-			return longWeekDayLowerNames[d-1]
+			return loc.LongWeekDayLower[d-1]
 		default:
 			return fmt.Sprintf("Day(%d)", d)
 		}
@@ -299,41 +162,69 @@ func (d day) string(code int) string {
 
 // Now returns the current local time.
 func Now() RTime {
-	return RTime{time.Now()}
+	return RTime{Time: time.Now()}
 }
 
 // Date is the envelope for the time.Date function and creates RTime for the passed date params.
 func Date(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) RTime {
 	t := time.Date(year, month, day, hour, min, sec, nsec, loc)
-	return RTime{t}
+	return RTime{Time: t}
 }
 
 // Unix is the envelope for the time.Unix function and creates RTime corresponding to the given Unix time,
 // sec seconds and nsec nanoseconds since January 1, 1970 UTC.
 func Unix(sec int64, nsec int64) RTime {
-	return RTime{time.Unix(sec, nsec)}
+	return RTime{Time: time.Unix(sec, nsec)}
 }
 
 // UnixMilli is the envelope for the time.UnixMilli and returns the local Time corresponding to the given Unix time,
 // msec milliseconds since January 1, 1970 UTC.
 func UnixMilli(msec int64) RTime {
-	return RTime{time.UnixMilli(msec)}
+	return RTime{Time: time.UnixMilli(msec)}
 }
 
 // UnixMicro is the envelope for the time.UnixMicro and returns the local Time corresponding to the given Unix time,
 // usec microseconds since January 1, 1970 UTC.
 func UnixMicro(usec int64) RTime {
-	return RTime{time.UnixMicro(usec)}
+	return RTime{Time: time.UnixMicro(usec)}
+}
+
+// NewInLocale returns the current local time bound to loc, so that later calls to Format
+// render month and weekday names from loc instead of the package-wide default locale.
+func NewInLocale(loc *Locale) RTime {
+	return RTime{Time: time.Now(), loc: loc}
 }
 
 type RTime struct {
 	time.Time
+
+	// loc is the locale bound via NewInLocale or WithLocale. A nil loc means Format falls
+	// back to the package-wide default locale.
+	loc *Locale
 }
 
 // Format returns a textual representation of the time value formatted according
 // to the layout defined by the argument. See the documentation for the
 // constant called Layout to see how to represent the layout format.
 func (t RTime) Format(layout string) string {
+	loc := t.loc
+	if loc == nil {
+		loc = defaultLocale.Load()
+	}
+	return t.FormatLocale(layout, loc)
+}
+
+// WithLocale returns a copy of t bound to loc, so that later calls to Format render month
+// and weekday names from loc regardless of the package-wide default locale. Unlike
+// SetDefaultLocale, it only affects t, so a single process can render Russian, Ukrainian and
+// Kazakh timestamps side by side without one goroutine's locale choice affecting another's.
+func (t RTime) WithLocale(loc *Locale) RTime {
+	return RTime{Time: t.Time, loc: loc}
+}
+
+// FormatLocale is like Format, but renders the Russian-style placeholders using the names
+// from loc, ignoring any locale t is bound to and the package-wide default locale.
+func (t RTime) FormatLocale(layout string, loc *Locale) string {
 	var b []byte
 	max := len(layout) + 10
 	b = make([]byte, 0, max)
@@ -357,41 +248,74 @@ func (t RTime) Format(layout string) string {
 		layout = suffix
 
 		switch std {
-		case codeLongMonth, codeLongMonthLower, codeMonth, codeMonthLower, codeLongMonthGenitive, codeLongMonthGenitiveLower:
-			m := month.string(std)
+		case codeLongMonth, codeLongMonthLower, codeMonth, codeMonthLower, codeLongMonthGenitive, codeLongMonthGenitiveLower,
+			codeLongMonthDative, codeLongMonthDativeLower, codeLongMonthInstrumental, codeLongMonthInstrumentalLower,
+			codeLongMonthPrepositional, codeLongMonthPrepositionalLower:
+			m := month.string(std, loc)
 			b = append(b, m...)
 		case codeWeekDay, codeWeekDayLower, codeLongWeekDay, codeLongWeekDayLower:
-			d := day.string(std)
+			d := day.string(std, loc)
 			b = append(b, d...)
+		case codeYearSuffix:
+			b = append(b, loc.YearSuffix...)
+		case codeOrdinalNeuter, codeOrdinalNeuterLong, codeOrdinalMasculine, codeOrdinalMasculineLong, codeOrdinalGenitive:
+			// A sentinel rune stands in for the real day digits here: splicing "1-го"
+			// straight into the layout would let t.Time.Format below mistake the "1"
+			// for its own no-leading-zero month code. The sentinel is swapped for the
+			// actual ordinal text after t.Time.Format has already run.
+			b = append(b, ordinalSentinels[ordinalFormForCode(std)]...)
 		}
 	}
 
 	layout = string(b)
-
-	return t.Time.Format(layout)
+	result := t.Time.Format(layout)
+	for form, sentinel := range ordinalSentinels {
+		if strings.Contains(result, sentinel) {
+			result = strings.ReplaceAll(result, sentinel, OrdinalDay(t.Day(), form))
+		}
+	}
+	return result
 }
 
 func nextChunk(layout string) (prefix string, std int, suffix string) {
 	rLayout := []rune(layout)
 	for i, r := range rLayout {
 		switch c := r; c {
-		case 'Я': // Январь, Янв, Января
+		case 'Я': // Январь, Янв, Января, Январю, Январём, Январе
 			if len(rLayout) >= i+3 && string(rLayout[i:i+3]) == "Янв" {
-				if len(rLayout) >= i+6 && string(rLayout[i:i+6]) == "Январь" {
-					return string(rLayout[0:i]), codeLongMonth, string(rLayout[i+6:])
+				if len(rLayout) >= i+7 && string(rLayout[i:i+7]) == "Январём" {
+					return string(rLayout[0:i]), codeLongMonthInstrumental, string(rLayout[i+7:])
 				}
-				if len(rLayout) >= i+6 && string(rLayout[i:i+6]) == "Января" {
-					return string(rLayout[0:i]), codeLongMonthGenitive, string(rLayout[i+6:])
+				if len(rLayout) >= i+6 {
+					switch string(rLayout[i : i+6]) {
+					case "Январь":
+						return string(rLayout[0:i]), codeLongMonth, string(rLayout[i+6:])
+					case "Января":
+						return string(rLayout[0:i]), codeLongMonthGenitive, string(rLayout[i+6:])
+					case "Январю":
+						return string(rLayout[0:i]), codeLongMonthDative, string(rLayout[i+6:])
+					case "Январе":
+						return string(rLayout[0:i]), codeLongMonthPrepositional, string(rLayout[i+6:])
+					}
 				}
 				return string(rLayout[0:i]), codeMonth, string(rLayout[i+3:])
 			}
-		case 'я': // январь, янв, января
+		case 'я': // январь, янв, января, январю, январём, январе
 			if len(rLayout) >= i+3 && string(rLayout[i:i+3]) == "янв" {
-				if len(rLayout) >= i+6 && string(rLayout[i:i+6]) == "январь" {
-					return string(rLayout[0:i]), codeLongMonthLower, string(rLayout[i+6:])
+				if len(rLayout) >= i+7 && string(rLayout[i:i+7]) == "январём" {
+					return string(rLayout[0:i]), codeLongMonthInstrumentalLower, string(rLayout[i+7:])
 				}
-				if len(rLayout) >= i+6 && string(rLayout[i:i+6]) == "января" {
-					return string(rLayout[0:i]), codeLongMonthGenitiveLower, string(rLayout[i+6:])
+				if len(rLayout) >= i+6 {
+					switch string(rLayout[i : i+6]) {
+					case "январь":
+						return string(rLayout[0:i]), codeLongMonthLower, string(rLayout[i+6:])
+					case "января":
+						return string(rLayout[0:i]), codeLongMonthGenitiveLower, string(rLayout[i+6:])
+					case "январю":
+						return string(rLayout[0:i]), codeLongMonthDativeLower, string(rLayout[i+6:])
+					case "январе":
+						return string(rLayout[0:i]), codeLongMonthPrepositionalLower, string(rLayout[i+6:])
+					}
 				}
 				return string(rLayout[0:i]), codeMonthLower, string(rLayout[i+3:])
 			}
@@ -409,47 +333,230 @@ func nextChunk(layout string) (prefix string, std int, suffix string) {
 			if len(rLayout) >= i+11 && string(rLayout[i:i+11]) == "понедельник" {
 				return string(rLayout[0:i]), codeLongWeekDayLower, string(rLayout[i+11:])
 			}
+		case 'г': // г.
+			if len(rLayout) >= i+2 && string(rLayout[i:i+2]) == "г." {
+				return string(rLayout[0:i]), codeYearSuffix, string(rLayout[i+2:])
+			}
+		case '2': // 2-е, 2-ое, 2-й, 2-ой, 2-го
+			if len(rLayout) >= i+2 && string(rLayout[i:i+2]) == "2-" {
+				rest := rLayout[i+2:]
+				switch {
+				case len(rest) >= 2 && string(rest[0:2]) == "го":
+					return string(rLayout[0:i]), codeOrdinalGenitive, string(rest[2:])
+				case len(rest) >= 2 && string(rest[0:2]) == "ое":
+					return string(rLayout[0:i]), codeOrdinalNeuterLong, string(rest[2:])
+				case len(rest) >= 2 && string(rest[0:2]) == "ой":
+					return string(rLayout[0:i]), codeOrdinalMasculineLong, string(rest[2:])
+				case len(rest) >= 1 && rest[0] == 'е':
+					return string(rLayout[0:i]), codeOrdinalNeuter, string(rest[1:])
+				case len(rest) >= 1 && rest[0] == 'й':
+					return string(rLayout[0:i]), codeOrdinalMasculine, string(rest[1:])
+				}
+			}
 		}
 
 	}
 	return layout, 0, ""
 }
 
+// Parse parses a formatted string and returns the time value it represents, much like
+// time.Parse. In addition to every placeholder time.Parse understands, Parse also accepts
+// the Russian month/weekday placeholders supported by Format (Январь/январь/Янв/янв,
+// Января/января, Понедельник/понедельник/ПН/пн) anywhere in layout.
+func Parse(layout, value string) (RTime, error) {
+	engLayout, engValue, err := translateRussian(layout, value)
+	if err != nil {
+		return RTime{}, err
+	}
+
+	t, err := time.Parse(engLayout, engValue)
+	if err != nil {
+		return RTime{}, err
+	}
+	return RTime{Time: t}, nil
+}
+
+// ParseInLocation is like Parse but differs in two important ways. First, in the absence
+// of time zone information, Parse interprets a time as UTC; ParseInLocation interprets the
+// time as in the given location. Second, when given a zone offset or abbreviation, Parse
+// tries to match it against the Local location; ParseInLocation uses the given location.
+func ParseInLocation(layout, value string, loc *time.Location) (RTime, error) {
+	return parse(layout, value, loc)
+}
+
+func parse(layout, value string, loc *time.Location) (RTime, error) {
+	engLayout, engValue, err := translateRussian(layout, value)
+	if err != nil {
+		return RTime{}, err
+	}
+
+	t, err := time.ParseInLocation(engLayout, engValue, loc)
+	if err != nil {
+		return RTime{}, err
+	}
+	return RTime{Time: t}, nil
+}
+
+// translateRussian walks layout with the same nextChunk state machine Format uses, but
+// instead of substituting the current time into the layout, it rewrites the Russian
+// placeholder into its English time equivalent and looks up the matching Russian word in
+// value, replacing it with that same English word. The result is an English layout/value
+// pair that time.ParseInLocation can consume directly.
+func translateRussian(layout, value string) (outLayout, outValue string, err error) {
+	var b []byte
+	outValue = value
+	loc := defaultLocale.Load()
+
+	rLayout := layout
+	for rLayout != "" {
+		prefix, std, suffix := nextChunk(rLayout)
+		b = append(b, prefix...)
+		if std == 0 {
+			break
+		}
+		rLayout = suffix
+
+		var eng string
+		switch std {
+		case codeLongMonth, codeLongMonthLower, codeMonth, codeMonthLower, codeLongMonthGenitive, codeLongMonthGenitiveLower,
+			codeLongMonthDative, codeLongMonthDativeLower, codeLongMonthInstrumental, codeLongMonthInstrumentalLower,
+			codeLongMonthPrepositional, codeLongMonthPrepositionalLower:
+			names := monthNamesForCode(std, loc)
+			pos, i := indexOfAny(outValue, names)
+			if pos < 0 {
+				return "", "", fmt.Errorf("rtime: cannot find a Russian month name for layout %q in value %q", layout, value)
+			}
+			// found is substituted into outValue in place of the matched Russian word; eng is
+			// the layout token and must stay "January"/"Jan" regardless of which month was
+			// found, since that is the only month name time.Parse's layout grammar recognizes.
+			found := time.Month(i + 1).String()
+			eng = time.Month(1).String()
+			if std == codeMonth || std == codeMonthLower {
+				found = found[:3]
+				eng = eng[:3]
+			}
+			outValue = outValue[:pos] + found + outValue[pos+len(names[i]):]
+		case codeWeekDay, codeWeekDayLower, codeLongWeekDay, codeLongWeekDayLower:
+			names := weekDayNamesForCode(std, loc)
+			pos, i := indexOfAny(outValue, names)
+			if pos < 0 {
+				return "", "", fmt.Errorf("rtime: cannot find a Russian weekday name for layout %q in value %q", layout, value)
+			}
+			// Same split as above: found goes into outValue, eng (the layout token) must
+			// always be "Monday"/"Mon", the only weekday name time.Parse's layout recognizes.
+			found := time.Weekday((i + 1) % 7).String()
+			eng = time.Monday.String()
+			if std == codeWeekDay || std == codeWeekDayLower {
+				found = found[:3]
+				eng = eng[:3]
+			}
+			outValue = outValue[:pos] + found + outValue[pos+len(names[i]):]
+		case codeYearSuffix:
+			eng = loc.YearSuffix
+		case codeOrdinalNeuter, codeOrdinalNeuterLong, codeOrdinalMasculine, codeOrdinalMasculineLong, codeOrdinalGenitive:
+			// Keep the placeholder text as it is: time.Parse reads its "2" as the day of
+			// month and matches the ordinal suffix after it literally.
+			eng = "2-" + ordinalSuffixes[ordinalFormForCode(std)]
+		}
+		b = append(b, eng...)
+	}
+
+	return string(b), outValue, nil
+}
+
+// monthNamesForCode returns the name list loc substitutes for the given month code.
+func monthNamesForCode(code int, loc *Locale) []string {
+	switch code {
+	case codeLongMonth:
+		return loc.LongMonth
+	case codeLongMonthLower:
+		return loc.LongMonthLower
+	case codeMonth:
+		return loc.Month
+	case codeMonthLower:
+		return loc.MonthLower
+	case codeLongMonthGenitive:
+		return loc.LongMonthGenitive
+	case codeLongMonthGenitiveLower:
+		return loc.LongMonthGenitiveLower
+	case codeLongMonthDative:
+		return loc.LongMonthDative
+	case codeLongMonthDativeLower:
+		return loc.LongMonthDativeLower
+	case codeLongMonthInstrumental:
+		return loc.LongMonthInstrumental
+	case codeLongMonthInstrumentalLower:
+		return loc.LongMonthInstrumentalLower
+	case codeLongMonthPrepositional:
+		return loc.LongMonthPrepositional
+	case codeLongMonthPrepositionalLower:
+		return loc.LongMonthPrepositionalLower
+	}
+	return nil
+}
+
+// weekDayNamesForCode returns the name list loc substitutes for the given weekday code.
+func weekDayNamesForCode(code int, loc *Locale) []string {
+	switch code {
+	case codeWeekDay:
+		return loc.WeekDay
+	case codeWeekDayLower:
+		return loc.WeekDayLower
+	case codeLongWeekDay:
+		return loc.LongWeekDay
+	case codeLongWeekDayLower:
+		return loc.LongWeekDayLower
+	}
+	return nil
+}
+
+// indexOfAny returns the position of the earliest occurrence in s of any name in names,
+// along with that name's index in names. It returns (-1, -1) if none of names occurs in s.
+func indexOfAny(s string, names []string) (pos, index int) {
+	pos, index = -1, -1
+	for i, name := range names {
+		if j := strings.Index(s, name); j >= 0 && (pos == -1 || j < pos) {
+			pos, index = j, i
+		}
+	}
+	return pos, index
+}
+
 // Add is the envelope for the Time.Add and returns the time t+d.
 func (t RTime) Add(d time.Duration) RTime {
-	return RTime{t.Time.Add(d)}
+	return RTime{Time: t.Time.Add(d), loc: t.loc}
 }
 
 // AddDate is the envelope for the Time.AddDate and returns the time corresponding to adding the
 // given number of years, months, and days to t.
 func (t RTime) AddDate(years int, months int, days int) RTime {
-	return RTime{t.Time.AddDate(years, months, days)}
+	return RTime{Time: t.Time.AddDate(years, months, days), loc: t.loc}
 }
 
 // UTC is the envelope for the Time.UTC and returns t with the location set to UTC.
 func (t RTime) UTC() RTime {
-	return RTime{t.Time.UTC()}
+	return RTime{Time: t.Time.UTC(), loc: t.loc}
 }
 
 // Local is the envelope for the Time.Local and returns t with the location set to local time.
 func (t RTime) Local() RTime {
-	return RTime{t.Time.Local()}
+	return RTime{Time: t.Time.Local(), loc: t.loc}
 }
 
 // In is the envelope for the Time.In returns a copy of t representing the same time instant, but
 // with the copy's location information set to loc for display purposes.
 func (t RTime) In(loc *time.Location) RTime {
-	return RTime{t.Time.In(loc)}
+	return RTime{Time: t.Time.In(loc), loc: t.loc}
 }
 
 // Truncate is the envelope for the Time.Truncate
 // and returns the result of rounding t down to a multiple of d (since the zero time).
 func (t RTime) Truncate(d time.Duration) RTime {
-	return RTime{t.Time.Truncate(d)}
+	return RTime{Time: t.Time.Truncate(d), loc: t.loc}
 }
 
 // Round is the envelope for the Time.Round
 // and returns the result of rounding t to the nearest multiple of d (since the zero time).
 func (t RTime) Round(d time.Duration) RTime {
-	return RTime{t.Time.Round(d)}
+	return RTime{Time: t.Time.Round(d), loc: t.loc}
 }
@@ -1,6 +1,8 @@
 package rtime_test
 
 import (
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -75,6 +77,139 @@ func TestDate(t *testing.T) {
 	}
 }
 
+func TestParse(t *testing.T) {
+	rt, err := rtime.Parse(rtime.GOST2016Word, "1 марта 2023 г.")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rt.Format(rtime.GOST2016Numeric); got != "01.03.2023" {
+		t.Errorf("expected 01.03.2023, actual %s", got)
+	}
+
+	rt, err = rtime.Parse("2 января 2006 г., Понедельник", "1 марта 2023 г., Среда")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rt.Format(rtime.GOST2016Word); got != "1 марта 2023 г." {
+		t.Errorf("expected 1 марта 2023 г., actual %s", got)
+	}
+
+	if _, err := rtime.Parse(rtime.GOST2016Word, "1 невезряб 2023 г."); err == nil {
+		t.Error("expected an error for an unrecognized month name")
+	}
+}
+
+func TestParseInLocation(t *testing.T) {
+	rt, err := rtime.ParseInLocation(rtime.GOST2016Word, "20 марта 1993 г.", loc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := rt.Format(time.RFC3339), "1993-03-20T00:00:00+03:00"; got != want {
+		t.Errorf("expected %s, actual %s", want, got)
+	}
+}
+
+func TestFormatLocale(t *testing.T) {
+	rt := rtime.RTime{Time: t1}
+	if got, want := rt.FormatLocale(rtime.GOST2016Word, rtime.LocaleUK), "1 березня 2023 р."; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+	if got, want := rt.FormatLocale(rtime.GOST2016Word, rtime.LocaleKK), "1 наурыз 2023 ж."; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+}
+
+func TestNewInLocale(t *testing.T) {
+	rt := rtime.NewInLocale(rtime.LocaleKK)
+	want := rtime.LocaleKK.LongMonth[rt.Time.Month()-1]
+	if got := rt.Format("Январь"); got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+}
+
+func TestStrftime(t *testing.T) {
+	rt := rtime.RTime{Time: t1}
+
+	if got, want := rt.Strftime("%A, %-d %B %Y г."), "Среда, 1 марта 2023 г."; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+	if got, want := rt.Strftime("%Y-%m-%dT%H:%M:%S"), "2023-03-01T02:48:05"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+	if got, want := rt.Strftime("%d %OB %Y Jan"), "01 Март 2023 Jan"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+	if got, want := rt.Strftime("cost PM dollars"), "cost PM dollars"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+	if got := rt.Strftime("%Q"); !strings.Contains(got, "unknown strftime specifier") {
+		t.Errorf("expected an error marker for an unknown specifier, actual %q", got)
+	}
+}
+
+func TestLayoutFromStrftime(t *testing.T) {
+	layout, err := rtime.LayoutFromStrftime("%A, %-d %B %Y г.")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "Понедельник, 2 января 2006 г."; layout != want {
+		t.Errorf("expected %q, actual %q", want, layout)
+	}
+}
+
+func TestFormatOrdinalDay(t *testing.T) {
+	rt := rtime.RTime{Time: t1}
+
+	cases := map[string]string{
+		"2-е января 2006 г.":  "1-е марта 2023 г.",
+		"2-ое января 2006 г.": "1-ое марта 2023 г.",
+		"2-й января 2006 г.":  "1-й марта 2023 г.",
+		"2-ой января 2006 г.": "1-ой марта 2023 г.",
+		"2-го января 2006 г.": "1-го марта 2023 г.",
+	}
+	for layout, expected := range cases {
+		if got := rt.Format(layout); got != expected {
+			t.Errorf("layout %q: expected %q, actual %q", layout, expected, got)
+		}
+	}
+
+	rt23 := rtime.RTime{Time: t2}
+	if got, want := rt23.Format("2-го числа"), "20-го числа"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+}
+
+func TestOrdinalDay(t *testing.T) {
+	if got, want := rtime.OrdinalDay(1, rtime.OrdinalGenitive), "1-го"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+	if got, want := rtime.OrdinalDay(23, rtime.OrdinalNeuter), "23-е"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+}
+
+func TestFormatGrammaticalCases(t *testing.T) {
+	rt := rtime.RTime{Time: t1}
+
+	cases := map[string]string{
+		"Январю марта":  "Марту марта",
+		"январю марта":  "марту марта",
+		"Январём марта": "Мартом марта",
+		"январём марта": "мартом марта",
+		"Январе марта":  "Марте марта",
+		"январе марта":  "марте марта",
+	}
+	for layout, expected := range cases {
+		if got := rt.Format(layout); got != expected {
+			t.Errorf("layout %q: expected %q, actual %q", layout, expected, got)
+		}
+	}
+
+	if got, want := rt.FormatLocale("Январе", rtime.LocaleUK), "Березні"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+}
+
 func TestAdd(t *testing.T) {
 	loc, _ := time.LoadLocation("Europe/Moscow")
 	rt := rtime.Date(2023, 03, 1, 02, 48, 05, 0, loc)
@@ -83,3 +218,64 @@ func TestAdd(t *testing.T) {
 		t.Errorf("Expected 02.03.2023, actual %s", rt.Format(rtime.GOST2016Numeric))
 	}
 }
+
+func TestWithLocale(t *testing.T) {
+	rt := rtime.RTime{Time: t1}
+	rt = rt.WithLocale(rtime.LocaleUK)
+	if got, want := rt.Format("Январе"), "Березні"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+}
+
+func TestDefaultLocaleRegistry(t *testing.T) {
+	original := rtime.DefaultLocale()
+	defer rtime.SetDefaultLocale(original)
+
+	rtime.SetDefaultLocale(rtime.LocaleUK)
+	if got := rtime.DefaultLocale(); got != rtime.LocaleUK {
+		t.Errorf("expected DefaultLocale to return LocaleUK, actual %v", got)
+	}
+	rt := rtime.RTime{Time: t1}
+	if got, want := rt.Format("Январе"), "Березні"; got != want {
+		t.Errorf("expected %q, actual %q", want, got)
+	}
+
+	rtime.RegisterLocale("custom", rtime.LocaleSR)
+	got, ok := rtime.LookupLocale("custom")
+	if !ok || got != rtime.LocaleSR {
+		t.Errorf("expected LookupLocale(%q) to return LocaleSR, got %v, %v", "custom", got, ok)
+	}
+	if _, ok := rtime.LookupLocale("no-such-locale"); ok {
+		t.Error("expected no locale registered under \"no-such-locale\"")
+	}
+
+	ru, ok := rtime.LookupLocale("ru")
+	if !ok || ru != rtime.LocaleRU {
+		t.Errorf("expected the built-in \"ru\" locale to be pre-registered as LocaleRU")
+	}
+}
+
+// TestDefaultLocaleConcurrentAccess hammers Format and SetDefaultLocale from many goroutines
+// at once; run with -race to confirm defaultLocale's atomic.Pointer actually prevents the
+// data race the old mutable package-level Locale had.
+func TestDefaultLocaleConcurrentAccess(t *testing.T) {
+	original := rtime.DefaultLocale()
+	defer rtime.SetDefaultLocale(original)
+
+	rt := rtime.RTime{Time: t1}
+	locales := []*rtime.Locale{rtime.LocaleRU, rtime.LocaleUK, rtime.LocaleBE, rtime.LocaleKK, rtime.LocaleBG, rtime.LocaleSR}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = rt.Format(rtime.GOST2016Word)
+		}()
+		go func(i int) {
+			defer wg.Done()
+			rtime.SetDefaultLocale(locales[i%len(locales)])
+		}(i)
+	}
+	wg.Wait()
+}
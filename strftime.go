@@ -0,0 +1,132 @@
+package rtime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// escapeRune separates adjacent literal bytes that would otherwise collide with Go's
+// reference-date magic inside a translated layout (e.g. a literal "Jan" being mistaken
+// for the %b month abbreviation). It is stripped from the final output by Strftime.
+const escapeRune = '\u200b'
+
+// riskyRunes are the bytes that make up Go's reference time "Mon Jan 2 15:04:05 MST 2006"
+// and its "_2"/digit/"PM"/"pm" placeholders. Any of them appearing in literal strftime text
+// must be kept from forming one of those substrings by accident. Go's layout mini-language
+// has no true escape mechanism of its own, so this only guards the multi-byte sequences
+// ("Jan", "Mon", "MST", "PM", "pm", "2006", "01".."06"); a lone "1".."5" digit in literal
+// text is still read as its single-character placeholder (month/day/hour/minute/second) by
+// time.Format, same as it would be for any other caller building a Go layout by hand.
+var riskyRunes = map[rune]bool{
+	'M': true, 'o': true, 'n': true, 'J': true, 'a': true, '_': true,
+	'P': true, 'p': true,
+	'0': true, '1': true, '2': true, '3': true, '4': true, '5': true,
+	'6': true, '7': true, '8': true, '9': true,
+}
+
+// escapeLiteral inserts escapeRune after every risky byte in s so the result cannot be
+// mistaken for a Go layout placeholder once it reaches time.Format.
+func escapeLiteral(s string) string {
+	if s == "" {
+		return s
+	}
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteRune(r)
+		if riskyRunes[r] {
+			b.WriteRune(escapeRune)
+		}
+	}
+	return b.String()
+}
+
+// strftimeSpecs maps POSIX strftime conversion specifiers to this package's native layout,
+// the same dialect Format understands (Go's reference layout plus the Russian placeholders).
+var strftimeSpecs = map[string]string{
+	"%Y":  "2006",
+	"%y":  "06",
+	"%m":  "01",
+	"%d":  "02",
+	"%-d": "2",
+	"%e":  "_2",
+	"%H":  "15",
+	"%I":  "03",
+	"%M":  "04",
+	"%S":  "05",
+	"%p":  "PM",
+	"%P":  "pm",
+	"%Z":  "MST",
+	"%z":  "-0700",
+	"%A":  "Понедельник",
+	"%a":  "ПН",
+	"%B":  "января", // genitive: the only grammatically correct standalone Russian month form
+	"%b":  "Янв",
+	"%OB": "Январь", // nominative, for callers building their own sentence around the month
+	"%Ob": "янв",
+}
+
+// LayoutFromStrftime translates a POSIX strftime-style layout (as understood by Python's
+// or PHP's strftime, or C's) into this package's native layout, so it can be passed to
+// Format or RTime.FormatLocale. Literal text is preserved as-is, escaped where necessary so
+// it cannot be mistaken for one of Go's reference-date placeholders. Callers that format the
+// same layout repeatedly can call LayoutFromStrftime once and reuse the result.
+func LayoutFromStrftime(s string) (string, error) {
+	var out strings.Builder
+	var literal strings.Builder
+
+	flushLiteral := func() {
+		out.WriteString(escapeLiteral(literal.String()))
+		literal.Reset()
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		if runes[i] != '%' {
+			literal.WriteRune(runes[i])
+			i++
+			continue
+		}
+		flushLiteral()
+
+		if i+1 >= len(runes) {
+			return "", fmt.Errorf("rtime: dangling %% at the end of %q", s)
+		}
+
+		var spec string
+		switch {
+		case runes[i+1] == '%':
+			literal.WriteRune('%')
+			i += 2
+			continue
+		case (runes[i+1] == 'O' || runes[i+1] == '-') && i+2 < len(runes):
+			spec, i = "%"+string(runes[i+1:i+3]), i+3
+		default:
+			spec, i = "%"+string(runes[i+1]), i+2
+		}
+
+		native, ok := strftimeSpecs[spec]
+		if !ok {
+			return "", fmt.Errorf("rtime: unknown strftime specifier %q", spec)
+		}
+		out.WriteString(native)
+	}
+	flushLiteral()
+
+	return out.String(), nil
+}
+
+// Strftime formats t using a POSIX strftime-style layout instead of the Go reference-date
+// layout Format expects, for callers coming from Python, PHP or C. It understands the
+// common conversions (%Y, %m, %d, %H, %M, %S, %p, %Z, %z, ...) plus the Russian-aware
+// %A/%a/%B/%b/%OB/%Ob family and delegates the rest to Format. %B/%b render the genitive
+// form ("1 марта"), the only grammatically correct standalone Russian date; %OB/%Ob render
+// the nominative instead, for callers composing their own sentence around the month. An
+// unknown specifier is reported inline, the same way fmt reports a bad verb, since Strftime
+// has no error return.
+func (t RTime) Strftime(layout string) string {
+	goLayout, err := LayoutFromStrftime(layout)
+	if err != nil {
+		return fmt.Sprintf("%%!Strftime(%v)", err)
+	}
+	return strings.ReplaceAll(t.Format(goLayout), string(escapeRune), "")
+}